@@ -0,0 +1,223 @@
+// Package alerts lets an MCP client register threshold rules against the
+// metrics this server already samples (e.g. "cpu.usage > 85% for 30s") and
+// be notified when a rule trips or clears, instead of having to poll the
+// point-in-time tools.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notifier delivers an alert event to subscribed clients. *server.MCPServer
+// satisfies this via SendNotificationToAllClients, and it is also the seam
+// the SSE transport pushes events through.
+type Notifier interface {
+	SendNotificationToAllClients(method string, params map[string]any)
+}
+
+// Event describes a rule transitioning between triggered and cleared.
+type Event struct {
+	RuleID    string    `json:"rule_id"`
+	Metric    Metric    `json:"metric"`
+	Label     string    `json:"label,omitempty"`
+	Value     float64   `json:"value"`
+	Triggered bool      `json:"triggered"`
+	At        time.Time `json:"at"`
+}
+
+// Manager samples metrics on an interval, evaluates rules with hysteresis,
+// emits Events through a Notifier, and persists the rule set to disk.
+type Manager struct {
+	mu       sync.Mutex
+	rules    map[string]*Rule
+	path     string
+	interval time.Duration
+	notifier Notifier
+
+	cancel context.CancelFunc
+}
+
+// NewManager loads any rules persisted at path and returns a Manager that
+// samples metrics every interval. An empty path makes the Manager
+// ephemeral: rules are kept in memory only, which is what the probe
+// command uses so it can smoke-test the alerts tool without touching
+// disk.
+func NewManager(path string, interval time.Duration, notifier Notifier) (*Manager, error) {
+	loaded, err := loadRules(path)
+	if err != nil {
+		return nil, fmt.Errorf("load rules from %s: %w", path, err)
+	}
+
+	rules := make(map[string]*Rule, len(loaded))
+	for i := range loaded {
+		r := loaded[i]
+		rules[r.ID] = &r
+	}
+
+	return &Manager{
+		rules:    rules,
+		path:     path,
+		interval: interval,
+		notifier: notifier,
+	}, nil
+}
+
+// Start runs the sampling loop until ctx is cancelled or Stop is called.
+func (m *Manager) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	m.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.tick(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts the sampling loop.
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// tick samples every metric the current rules reference and evaluates each
+// rule's hysteresis state against the fresh readings.
+func (m *Manager) tick(ctx context.Context) {
+	m.mu.Lock()
+	rules := make([]Rule, 0, len(m.rules))
+	for _, r := range m.rules {
+		rules = append(rules, *r)
+	}
+	m.mu.Unlock()
+	if len(rules) == 0 {
+		return
+	}
+
+	samples := readSamples(ctx, rules)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.rules {
+		for _, s := range samples {
+			if s.metric != r.Metric || s.label != r.Label {
+				continue
+			}
+			m.evaluate(r, s)
+		}
+	}
+}
+
+// evaluate applies dwell-time hysteresis: a rule only flips state once the
+// breach (or its absence) has held continuously for r.For.
+func (m *Manager) evaluate(r *Rule, s sample) {
+	breached := r.breached(s.value)
+
+	if breached == r.Triggered {
+		r.ConditionSince = time.Time{}
+		return
+	}
+
+	if r.ConditionSince.IsZero() {
+		r.ConditionSince = s.ts
+		return
+	}
+
+	if s.ts.Sub(r.ConditionSince) < r.For {
+		return
+	}
+
+	r.Triggered = breached
+	r.ConditionSince = time.Time{}
+	m.emit(r, s)
+}
+
+func (m *Manager) emit(r *Rule, s sample) {
+	if m.notifier == nil {
+		return
+	}
+	ev := Event{
+		RuleID:    r.ID,
+		Metric:    r.Metric,
+		Label:     r.Label,
+		Value:     s.value,
+		Triggered: r.Triggered,
+		At:        s.ts,
+	}
+	m.notifier.SendNotificationToAllClients("alerts/event", map[string]any{
+		"rule_id":   ev.RuleID,
+		"metric":    string(ev.Metric),
+		"label":     ev.Label,
+		"value":     ev.Value,
+		"triggered": ev.Triggered,
+		"at":        ev.At,
+	})
+}
+
+// AddRule validates and persists a new rule, returning the stored rule with
+// its assigned ID.
+func (m *Manager) AddRule(r Rule) (Rule, error) {
+	if r.Metric == "" {
+		return Rule{}, fmt.Errorf("metric is required")
+	}
+	if r.Op != OpGreaterThan && r.Op != OpLessThan {
+		return Rule{}, fmt.Errorf("op must be %q or %q", OpGreaterThan, OpLessThan)
+	}
+	if r.For <= 0 {
+		r.For = 30 * time.Second
+	}
+	r.ID = uuid.NewString()
+
+	m.mu.Lock()
+	m.rules[r.ID] = &r
+	err := m.persistLocked()
+	m.mu.Unlock()
+
+	if err != nil {
+		return Rule{}, err
+	}
+	return r, nil
+}
+
+// DeleteRule removes a rule by ID. It is not an error to delete an unknown
+// ID.
+func (m *Manager) DeleteRule(id string) error {
+	m.mu.Lock()
+	delete(m.rules, id)
+	err := m.persistLocked()
+	m.mu.Unlock()
+	return err
+}
+
+// ListRules returns a snapshot of every registered rule.
+func (m *Manager) ListRules() []Rule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Rule, 0, len(m.rules))
+	for _, r := range m.rules {
+		out = append(out, *r)
+	}
+	return out
+}
+
+// persistLocked writes the current rule set to disk. Callers must hold m.mu.
+func (m *Manager) persistLocked() error {
+	rules := make([]Rule, 0, len(m.rules))
+	for _, r := range m.rules {
+		rules = append(rules, *r)
+	}
+	return saveRules(m.path, rules)
+}
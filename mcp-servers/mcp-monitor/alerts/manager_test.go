@@ -0,0 +1,81 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+)
+
+type recordingNotifier struct {
+	events []map[string]any
+}
+
+func (r *recordingNotifier) SendNotificationToAllClients(method string, params map[string]any) {
+	r.events = append(r.events, params)
+}
+
+func TestManagerEvaluateHysteresis(t *testing.T) {
+	notifier := &recordingNotifier{}
+	m, err := NewManager("", time.Second, notifier)
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+
+	rule := &Rule{ID: "r1", Metric: MetricCPUUsage, Op: OpGreaterThan, Threshold: 80, For: 30 * time.Second}
+	base := time.Now()
+
+	cases := []struct {
+		name          string
+		at            time.Duration // offset from base
+		value         float64
+		wantTriggered bool
+		wantEvents    int
+	}{
+		{"starts clear, stays clear", 0, 50, false, 0},
+		{"breach starts the dwell timer, not yet triggered", 1 * time.Second, 90, false, 0},
+		{"breach short of the dwell time", 20 * time.Second, 90, false, 0},
+		{"breach persists past the dwell time, fires", 31 * time.Second, 90, true, 1},
+		{"still breached, no duplicate event", 32 * time.Second, 95, true, 1},
+		{"clears immediately, dwell restarts", 33 * time.Second, 50, true, 1},
+		{"clear persists past the dwell time, fires clear event", 64 * time.Second, 50, false, 2},
+	}
+
+	for _, tc := range cases {
+		m.evaluate(rule, sample{metric: rule.Metric, value: tc.value, ts: base.Add(tc.at)})
+
+		if rule.Triggered != tc.wantTriggered {
+			t.Errorf("%s: Triggered = %v, want %v", tc.name, rule.Triggered, tc.wantTriggered)
+		}
+		if len(notifier.events) != tc.wantEvents {
+			t.Errorf("%s: got %d notifications, want %d", tc.name, len(notifier.events), tc.wantEvents)
+		}
+	}
+}
+
+func TestManagerEvaluateLessThanOperator(t *testing.T) {
+	notifier := &recordingNotifier{}
+	m, err := NewManager("", time.Second, notifier)
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+
+	rule := &Rule{ID: "r2", Metric: MetricDiskFree, Op: OpLessThan, Threshold: 10, For: 10 * time.Second}
+	base := time.Now()
+
+	m.evaluate(rule, sample{metric: rule.Metric, value: 50, ts: base})
+	if rule.Triggered {
+		t.Fatal("rule should not trigger while disk.free is above the threshold")
+	}
+
+	m.evaluate(rule, sample{metric: rule.Metric, value: 5, ts: base.Add(1 * time.Second)})
+	if rule.Triggered {
+		t.Fatal("rule should not trigger before the dwell time elapses")
+	}
+
+	m.evaluate(rule, sample{metric: rule.Metric, value: 5, ts: base.Add(12 * time.Second)})
+	if !rule.Triggered {
+		t.Fatal("rule should trigger once the breach has held past the dwell time")
+	}
+	if len(notifier.events) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notifier.events))
+	}
+}
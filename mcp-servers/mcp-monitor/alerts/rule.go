@@ -0,0 +1,51 @@
+package alerts
+
+import "time"
+
+// Metric names a value the sampler knows how to read, optionally scoped to
+// a label such as a mount point or network interface.
+type Metric string
+
+const (
+	MetricCPUUsage    Metric = "cpu.usage"
+	MetricMemoryUsage Metric = "memory.usage"
+	MetricDiskFree    Metric = "disk.free"
+	MetricDiskUsage   Metric = "disk.usage"
+	MetricNetworkSent Metric = "network.sent"
+	MetricNetworkRecv Metric = "network.recv"
+)
+
+// Op is a threshold comparison operator.
+type Op string
+
+const (
+	OpGreaterThan Op = ">"
+	OpLessThan    Op = "<"
+)
+
+// Rule is a single alerting rule, e.g. "cpu.usage > 85% for 30s".
+type Rule struct {
+	ID        string        `json:"id"`
+	Metric    Metric        `json:"metric"`
+	Label     string        `json:"label,omitempty"` // e.g. a disk mount point or interface name
+	Op        Op            `json:"op"`
+	Threshold float64       `json:"threshold"`
+	For       time.Duration `json:"for"` // dwell time a breach must persist before firing
+
+	// Triggered and ConditionSince implement hysteresis: a rule only fires
+	// once the breach (or its clearing) has held continuously for For.
+	Triggered      bool      `json:"triggered"`
+	ConditionSince time.Time `json:"condition_since,omitempty"`
+}
+
+// breached reports whether value crosses the rule's threshold.
+func (r *Rule) breached(value float64) bool {
+	switch r.Op {
+	case OpGreaterThan:
+		return value > r.Threshold
+	case OpLessThan:
+		return value < r.Threshold
+	default:
+		return false
+	}
+}
@@ -0,0 +1,94 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// sample is a single (metric, label, value) reading taken at ts.
+type sample struct {
+	metric Metric
+	label  string
+	value  float64
+	ts     time.Time
+}
+
+// readSamples takes one reading per distinct (metric, label) pair the
+// configured rules care about, reusing the same gopsutil calls the
+// point-in-time cpu/memory/disk/network tools make.
+func readSamples(ctx context.Context, rules []Rule) []sample {
+	now := time.Now()
+	var out []sample
+
+	var needCPU, needMem bool
+	disks := map[string]bool{}
+	nics := map[string]bool{}
+	for _, r := range rules {
+		switch r.Metric {
+		case MetricCPUUsage:
+			needCPU = true
+		case MetricMemoryUsage:
+			needMem = true
+		case MetricDiskFree, MetricDiskUsage:
+			disks[r.Label] = true
+		case MetricNetworkSent, MetricNetworkRecv:
+			nics[r.Label] = true
+		}
+	}
+
+	if needCPU {
+		if pcts, err := cpu.PercentWithContext(ctx, 0, false); err == nil && len(pcts) > 0 {
+			out = append(out, sample{metric: MetricCPUUsage, value: pcts[0], ts: now})
+		}
+	}
+
+	if needMem {
+		if vm, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+			out = append(out, sample{metric: MetricMemoryUsage, value: vm.UsedPercent, ts: now})
+		}
+	}
+
+	for mount := range disks {
+		path := mount
+		if path == "" {
+			path = "/"
+		}
+		usage, err := disk.UsageWithContext(ctx, path)
+		if err != nil {
+			continue
+		}
+		out = append(out,
+			sample{metric: MetricDiskUsage, label: mount, value: usage.UsedPercent, ts: now},
+			sample{metric: MetricDiskFree, label: mount, value: 100 - usage.UsedPercent, ts: now},
+		)
+	}
+
+	if len(nics) > 0 {
+		if counters, err := net.IOCountersWithContext(ctx, true); err == nil && len(counters) > 0 {
+			var sent, recv uint64
+			for _, c := range counters {
+				sent += c.BytesSent
+				recv += c.BytesRecv
+				if nics[c.Name] {
+					out = append(out,
+						sample{metric: MetricNetworkSent, label: c.Name, value: float64(c.BytesSent), ts: now},
+						sample{metric: MetricNetworkRecv, label: c.Name, value: float64(c.BytesRecv), ts: now},
+					)
+				}
+			}
+			if nics[""] {
+				out = append(out,
+					sample{metric: MetricNetworkSent, value: float64(sent), ts: now},
+					sample{metric: MetricNetworkRecv, value: float64(recv), ts: now},
+				)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,51 @@
+package alerts
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// loadRules reads persisted rules from path. A missing file is treated as
+// an empty rule set rather than an error, so first runs don't need to seed
+// anything on disk. An empty path means the manager is ephemeral (e.g. the
+// probe command) and always starts with no rules.
+func loadRules(path string) ([]Rule, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// saveRules persists rules to path as indented JSON, creating parent
+// directories as needed. An empty path means the manager is ephemeral and
+// rules are never written to disk.
+func saveRules(path string, rules []Rule) error {
+	if path == "" {
+		return nil
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,109 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// manager backs the package-level tool Handler. It is set by Register once
+// a Manager has been constructed, following the same NewTool()/Handler
+// pairing the other collectors use.
+var manager *Manager
+
+// Register installs m as the backing Manager for the "alerts" tool and
+// starts its sampling loop.
+func Register(ctx context.Context, m *Manager) {
+	manager = m
+	m.Start(ctx)
+}
+
+// NewTool describes the "alerts" MCP tool, which manages threshold rules
+// over the metrics this server already samples.
+func NewTool() mcp.Tool {
+	return mcp.NewTool("alerts",
+		mcp.WithDescription("Create, list, or delete alert rules (e.g. \"cpu.usage > 85 for 30s\") and receive push notifications when they trip or clear"),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("one of: create, list, delete"),
+		),
+		mcp.WithString("metric",
+			mcp.Description("metric to watch, e.g. cpu.usage, memory.usage, disk.free, disk.usage, network.sent, network.recv (required for action=create)"),
+		),
+		mcp.WithString("label",
+			mcp.Description("optional scope for the metric, e.g. a disk mount point or network interface"),
+		),
+		mcp.WithString("op",
+			mcp.Description("comparison operator, \">\" or \"<\" (required for action=create)"),
+		),
+		mcp.WithNumber("threshold",
+			mcp.Description("threshold value the metric is compared against (required for action=create)"),
+		),
+		mcp.WithString("for",
+			mcp.Description("dwell time the breach must persist before firing, as a Go duration string (default 30s)"),
+		),
+		mcp.WithString("id",
+			mcp.Description("rule ID to delete (required for action=delete)"),
+		),
+	)
+}
+
+// Handler dispatches the alerts tool's create/list/delete actions against
+// the registered Manager.
+func Handler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("alerts manager not initialized")
+	}
+
+	action := request.GetString("action", "")
+	switch action {
+	case "create":
+		dwell := 30 * time.Second
+		if raw := request.GetString("for", ""); raw != "" {
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid for: %w", err)
+			}
+			dwell = d
+		}
+
+		r, err := manager.AddRule(Rule{
+			Metric:    Metric(request.GetString("metric", "")),
+			Label:     request.GetString("label", ""),
+			Op:        Op(request.GetString("op", "")),
+			Threshold: request.GetFloat("threshold", 0),
+			For:       dwell,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return jsonResult(r)
+
+	case "list":
+		return jsonResult(manager.ListRules())
+
+	case "delete":
+		id := request.GetString("id", "")
+		if id == "" {
+			return nil, fmt.Errorf("id is required for action=delete")
+		}
+		if err := manager.DeleteRule(id); err != nil {
+			return nil, err
+		}
+		return jsonResult(map[string]string{"deleted": id})
+
+	default:
+		return nil, fmt.Errorf("unknown action %q (want create, list, or delete)", action)
+	}
+}
+
+func jsonResult(v interface{}) (*mcp.CallToolResult, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal result: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
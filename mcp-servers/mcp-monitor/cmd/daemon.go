@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+)
+
+// startDetached launches a new instance of this binary with args, its
+// output redirected to logFile, detached from the current process group so
+// it keeps running after the parent (this CLI invocation) exits.
+func startDetached(binary string, args []string, logFile *os.File) (*os.Process, error) {
+	c := exec.Command(binary, args...)
+	c.Stdout = logFile
+	c.Stderr = logFile
+	c.Stdin = nil
+	c.SysProcAttr = detachedSysProcAttr()
+
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+	return c.Process, nil
+}
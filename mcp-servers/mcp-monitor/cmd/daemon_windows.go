@@ -0,0 +1,11 @@
+//go:build windows
+
+package cmd
+
+import "syscall"
+
+// detachedSysProcAttr starts the daemonized child with a new process group
+// so it survives the parent CLI invocation exiting.
+func detachedSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
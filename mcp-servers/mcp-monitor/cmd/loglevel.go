@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+)
+
+// logLevels ranks the supported --log-level values from most to least
+// verbose so logf can cheaply decide whether a message should be printed.
+var logLevels = map[string]int{
+	"debug": 0,
+	"info":  1,
+	"warn":  2,
+	"error": 3,
+}
+
+var currentLogLevel = logLevels["info"]
+
+// setLogLevel configures the minimum level logf will emit. An unknown level
+// falls back to "info".
+func setLogLevel(level string) {
+	l, ok := logLevels[level]
+	if !ok {
+		log.Printf("unknown --log-level %q, defaulting to \"info\"\n", level)
+		l = logLevels["info"]
+	}
+	currentLogLevel = l
+}
+
+// logf prints format/args when level is at or above the configured
+// --log-level.
+func logf(level, format string, args ...interface{}) {
+	if logLevels[level] < currentLogLevel {
+		return
+	}
+	log.Printf("[%s] %s", level, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/seekrays/mcp-monitor/alerts"
+	"github.com/seekrays/mcp-monitor/collectors"
+	"github.com/seekrays/mcp-monitor/history"
+	"github.com/seekrays/mcp-monitor/registry"
+	"github.com/spf13/cobra"
+)
+
+var probeTools string
+
+// probeArgs supplies the minimal arguments a tool needs to actually do
+// something when probed, instead of just tripping its own required-field
+// validation. Tools not listed here are probed with no arguments at all,
+// which is fine for collectors that have no required parameters.
+var probeArgs = map[string]map[string]any{
+	"alerts": {"action": "list"},
+}
+
+var probeCmd = &cobra.Command{
+	Use:   "probe",
+	Short: "Invoke each registered tool once and print its result as JSON, without starting the MCP server",
+	RunE:  runProbe,
+}
+
+func init() {
+	probeCmd.Flags().StringVar(&probeTools, "tools", "", "comma-separated list of collectors to probe (default: all)")
+}
+
+func runProbe(cmd *cobra.Command, args []string) error {
+	collectors.Register()
+	if err := registerStatefulForProbe(); err != nil {
+		return fmt.Errorf("register stateful tools: %w", err)
+	}
+
+	entries := registry.Filter(splitTools(probeTools))
+	if len(entries) == 0 {
+		return fmt.Errorf("no collectors matched --tools=%q", probeTools)
+	}
+
+	results := make(map[string]interface{}, len(entries))
+	for _, e := range entries {
+		req := mcp.CallToolRequest{}
+		req.Params.Name = e.Tool.Name
+		if defaults, ok := probeArgs[e.Name]; ok {
+			req.Params.Arguments = defaults
+		}
+
+		res, err := e.Handler(context.Background(), req)
+		if err != nil {
+			results[e.Name] = map[string]string{"error": err.Error()}
+			continue
+		}
+		results[e.Name] = res
+	}
+
+	out, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal probe results: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// registerStatefulForProbe wires the alerts and history tools into the
+// registry the same way runServe does, but with ephemeral, in-memory-only
+// backing state (no rules file, no running sampler beyond what's needed to
+// back the tool call). This exists so "probe" actually smoke-tests every
+// registered tool, not just the stateless collectors that self-register
+// through collectors.Register.
+func registerStatefulForProbe() error {
+	alertsManager, err := alerts.NewManager("", time.Hour, nil)
+	if err != nil {
+		return fmt.Errorf("init alerts manager: %w", err)
+	}
+	alerts.Register(context.Background(), alertsManager)
+	registry.Register("alerts", alerts.NewTool(), alerts.Handler)
+
+	historyStore := history.NewStore(nil)
+	for _, metric := range []string{history.MetricCPU, history.MetricMemory, history.MetricNetwork} {
+		registry.Register(metric+".history", history.NewTool(metric), history.NewHandler(historyStore, metric))
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// Package cmd implements the mcp-monitor command line interface.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "mcp-monitor",
+	Short: "MCP server that exposes system metrics as tools for LLM agents",
+}
+
+// Execute runs the root command, dispatching to the requested subcommand.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(probeCmd)
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/seekrays/mcp-monitor/alerts"
+	"github.com/seekrays/mcp-monitor/collectors"
+	"github.com/seekrays/mcp-monitor/history"
+	"github.com/seekrays/mcp-monitor/plugin"
+	"github.com/seekrays/mcp-monitor/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serveTransport       string
+	serveListen          string
+	serveAdvertiseURL    string
+	serveTools           string
+	serveLogLevel        string
+	servePprof           bool
+	serveDaemon          bool
+	serveRunDir          string
+	serveAlertsFile      string
+	serveAlertsInterval  time.Duration
+	serveHistoryInterval time.Duration
+	servePluginsDir      string
+	servePluginAllow     string
+	servePluginTimeout   time.Duration
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Start the MCP System Monitor server",
+	RunE:  runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveTransport, "transport", "stdio", "transport to serve on: stdio or http")
+	serveCmd.Flags().StringVar(&serveListen, "listen", ":8081", "address to listen on when --transport=http")
+	serveCmd.Flags().StringVar(&serveAdvertiseURL, "advertise-url", "", "base URL handed to SSE clients for reconnecting (http transport only; default: derived from --listen, which only works for a single reachable host/port, not a wildcard bind like 0.0.0.0)")
+	serveCmd.Flags().StringVar(&serveTools, "tools", "", "comma-separated list of collectors to enable (default: all)")
+	serveCmd.Flags().StringVar(&serveLogLevel, "log-level", "info", "log verbosity: debug, info, warn, or error")
+	serveCmd.Flags().BoolVar(&servePprof, "pprof", false, "mount net/http/pprof handlers under /debug/pprof (http transport only)")
+	serveCmd.Flags().BoolVar(&serveDaemon, "daemon", false, "fork into the background, writing a PID file and log file under --run-dir")
+	serveCmd.Flags().StringVar(&serveRunDir, "run-dir", "/var/run/mcp-monitor", "directory for the PID and log file when --daemon is set")
+	serveCmd.Flags().StringVar(&serveAlertsFile, "alerts-file", "/var/lib/mcp-monitor/alerts.json", "file alert rules are persisted to")
+	serveCmd.Flags().DurationVar(&serveAlertsInterval, "alerts-interval", 5*time.Second, "how often the alerts sampler re-evaluates rules")
+	serveCmd.Flags().DurationVar(&serveHistoryInterval, "history-interval", time.Second, "how often the history sampler records a new point")
+	serveCmd.Flags().StringVar(&servePluginsDir, "plugins-dir", "", "directory to scan for third-party collector plugins (default: disabled)")
+	serveCmd.Flags().StringVar(&servePluginAllow, "plugin-allow", "", "comma-separated list of plugin executable names to load (default: allow everything found in --plugins-dir)")
+	serveCmd.Flags().DurationVar(&servePluginTimeout, "plugin-timeout", 10*time.Second, "timeout for each call into a plugin subprocess")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	setLogLevel(serveLogLevel)
+
+	if serveDaemon {
+		return daemonize(serveRunDir)
+	}
+
+	logf("info", "Initializing MCP System Monitor...")
+
+	s := server.NewMCPServer("System Monitor", "1.0.0")
+	logf("info", "Created MCP server instance")
+
+	collectors.Register()
+
+	alertsManager, err := alerts.NewManager(serveAlertsFile, serveAlertsInterval, s)
+	if err != nil {
+		return fmt.Errorf("init alerts manager: %w", err)
+	}
+	alerts.Register(context.Background(), alertsManager)
+	registry.Register("alerts", alerts.NewTool(), alerts.Handler)
+
+	historyStore := history.NewStore(nil)
+	go history.RunSampler(context.Background(), historyStore, serveHistoryInterval)
+	for _, metric := range []string{history.MetricCPU, history.MetricMemory, history.MetricNetwork} {
+		registry.Register(metric+".history", history.NewTool(metric), history.NewHandler(historyStore, metric))
+	}
+
+	var pluginHost *plugin.Host
+	if servePluginsDir != "" {
+		pluginHost = plugin.NewHost(splitTools(servePluginAllow), servePluginTimeout)
+		if err := pluginHost.LoadDir(servePluginsDir); err != nil {
+			return fmt.Errorf("load plugins: %w", err)
+		}
+		defer pluginHost.Close()
+	}
+
+	entries := registry.Filter(splitTools(serveTools))
+	if len(entries) == 0 {
+		return fmt.Errorf("no collectors matched --tools=%q", serveTools)
+	}
+	for _, e := range entries {
+		logf("info", "Adding %s tool...", e.Name)
+		s.AddTool(e.Tool, e.Handler)
+	}
+
+	switch serveTransport {
+	case "stdio":
+		logf("info", "Starting MCP System Monitor server (stdio transport)...")
+		if err := server.ServeStdio(s); err != nil {
+			return fmt.Errorf("server error: %w", err)
+		}
+		return nil
+	case "http":
+		return serveHTTP(s, serveListen, serveAdvertiseURL, servePprof)
+	default:
+		return fmt.Errorf("unknown --transport %q (want \"stdio\" or \"http\")", serveTransport)
+	}
+}
+
+// splitTools turns a comma-separated --tools flag into a slice of names,
+// dropping empty entries so an unset flag still means "enable everything".
+func splitTools(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	var out []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// serveHTTP exposes the MCP tool registry over HTTP using Server-Sent Events
+// for streaming responses, alongside a /healthz endpoint and, optionally,
+// net/http/pprof handlers for debugging long-running instances.
+func serveHTTP(s *server.MCPServer, addr, advertiseURL string, pprofEnabled bool) error {
+	baseURL := advertiseURL
+	if baseURL == "" {
+		host, _, _ := net.SplitHostPort(addr)
+		if host == "0.0.0.0" || host == "::" {
+			return fmt.Errorf("--listen=%q binds a wildcard address that is not reachable by that literal value; set --advertise-url to the host/port remote clients can actually reach", addr)
+		}
+		baseURL = "http://" + addr
+	}
+	sse := server.NewSSEServer(s, server.WithBaseURL(baseURL))
+
+	mux := http.NewServeMux()
+	mux.Handle("/mcp/", sse)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+	if pprofEnabled {
+		logf("info", "Mounting net/http/pprof under /debug/pprof...")
+		mux.Handle("/debug/pprof/", http.DefaultServeMux)
+	}
+
+	logf("info", "Starting MCP System Monitor server (http+sse transport) on %s...", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		return fmt.Errorf("server error: %w", err)
+	}
+	return nil
+}
+
+// daemonize re-execs the current binary with --daemon stripped and its
+// stdout/stderr redirected to a log file under runDir, writes the child's
+// PID to runDir/mcp-monitor.pid, then exits the parent.
+func daemonize(runDir string) error {
+	if err := os.MkdirAll(runDir, 0o755); err != nil {
+		return fmt.Errorf("create run dir: %w", err)
+	}
+
+	logPath := filepath.Join(runDir, "mcp-monitor.log")
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+	defer logFile.Close()
+
+	childArgs := make([]string, 0, len(os.Args))
+	for _, a := range os.Args[1:] {
+		if a != "--daemon" && !strings.HasPrefix(a, "--daemon=") {
+			childArgs = append(childArgs, a)
+		}
+	}
+
+	proc, err := startDetached(os.Args[0], childArgs, logFile)
+	if err != nil {
+		return fmt.Errorf("start daemon: %w", err)
+	}
+
+	pidPath := filepath.Join(runDir, "mcp-monitor.pid")
+	if err := os.WriteFile(pidPath, []byte(fmt.Sprintf("%d\n", proc.Pid)), 0o644); err != nil {
+		return fmt.Errorf("write pid file: %w", err)
+	}
+
+	fmt.Printf("mcp-monitor daemonized as pid %d (log: %s, pid file: %s)\n", proc.Pid, logPath, pidPath)
+	return nil
+}
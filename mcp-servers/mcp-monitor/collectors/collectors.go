@@ -0,0 +1,28 @@
+// Package collectors wires the built-in system collectors into the shared
+// registry so the serve and probe commands can discover them without
+// importing each one individually.
+package collectors
+
+import (
+	"github.com/seekrays/mcp-monitor/cpu"
+	"github.com/seekrays/mcp-monitor/disk"
+	"github.com/seekrays/mcp-monitor/host"
+	"github.com/seekrays/mcp-monitor/memory"
+	"github.com/seekrays/mcp-monitor/network"
+	"github.com/seekrays/mcp-monitor/process"
+	"github.com/seekrays/mcp-monitor/registry"
+	"github.com/seekrays/mcp-monitor/sensors"
+)
+
+// Register adds every built-in collector to the shared registry. Each call
+// appends them again, so the serve and probe commands call it exactly once
+// at startup.
+func Register() {
+	registry.Register("cpu", cpu.NewTool(), cpu.Handler)
+	registry.Register("memory", memory.NewTool(), memory.Handler)
+	registry.Register("disk", disk.NewTool(), disk.Handler)
+	registry.Register("network", network.NewTool(), network.Handler)
+	registry.Register("host", host.NewTool(), host.Handler)
+	registry.Register("process", process.NewTool(), process.Handler)
+	registry.Register("sensors", sensors.NewTool(), sensors.Handler)
+}
@@ -0,0 +1,53 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// Metric names a series recorded by the sampler. These match the tool
+// names registered in collectors, e.g. "cpu" backs the cpu.history tool.
+const (
+	MetricCPU     = "cpu"
+	MetricMemory  = "memory"
+	MetricNetwork = "network"
+)
+
+// RunSampler records cpu, memory, and network usage into store every
+// interval, reusing the same gopsutil calls the point-in-time tools make,
+// until ctx is cancelled.
+func RunSampler(ctx context.Context, store *Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sample(ctx, store)
+		}
+	}
+}
+
+func sample(ctx context.Context, store *Store) {
+	now := time.Now()
+
+	if pcts, err := cpu.PercentWithContext(ctx, 0, false); err == nil && len(pcts) > 0 {
+		store.Record(MetricCPU, "", now, pcts[0])
+	}
+
+	if vm, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+		store.Record(MetricMemory, "", now, vm.UsedPercent)
+	}
+
+	if counters, err := net.IOCountersWithContext(ctx, true); err == nil {
+		for _, c := range counters {
+			store.Record(MetricNetwork, c.Name, now, float64(c.BytesSent+c.BytesRecv))
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package history
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Aggregation is how multiple raw samples are combined into one point when
+// a query's step is coarser than the tier backing it.
+type Aggregation string
+
+const (
+	AggAvg Aggregation = "avg"
+	AggMin Aggregation = "min"
+	AggMax Aggregation = "max"
+	AggP95 Aggregation = "p95"
+)
+
+// series is a single metric+label's data across every retention tier,
+// finest first. Recording cascades a value down into coarser tiers as each
+// finer tier's bucket closes.
+type series struct {
+	mu    sync.Mutex
+	tiers []*tier
+}
+
+func newSeries(cfgs []TierConfig) *series {
+	tiers := make([]*tier, len(cfgs))
+	for i, cfg := range cfgs {
+		tiers[i] = newTier(cfg)
+	}
+	return &series{tiers: tiers}
+}
+
+func (s *series) record(ts time.Time, v float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	curTs, curVal := ts, v
+	for _, t := range s.tiers {
+		closed, rolled := t.record(curTs, curVal)
+		if !rolled {
+			return
+		}
+		curTs, curVal = closed.Ts, closed.Value
+	}
+}
+
+// query picks, among tiers no coarser than the requested step, the finest
+// tier whose actually buffered data reaches back to since, falling back to
+// whichever matching tier holds the most history when none of them do (in
+// which case the result is truncated to however far back that tier goes).
+//
+// A tier's nominal retention (capacity * interval) is not a reliable proxy
+// for this: a tier's oldest buffered sample lags that nominal window by up
+// to one of its own bucket intervals, compounded by the cascade delay of
+// every finer tier feeding it, so a coarser tier can look like it "covers"
+// a range on paper while actually holding no data anywhere near it. Tiers
+// track their real buffered range via oldestSample, which this uses
+// instead.
+//
+// It then aggregates the chosen tier's raw samples into step-sized points.
+func (s *series) query(since, until time.Time, step time.Duration, agg Aggregation) []Sample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.tiers) == 0 {
+		return nil
+	}
+
+	var eligible []*tier
+	for _, t := range s.tiers {
+		if step > 0 && t.interval > step {
+			continue
+		}
+		eligible = append(eligible, t)
+	}
+	if len(eligible) == 0 {
+		eligible = s.tiers
+	}
+
+	// Tiers are ordered finest to coarsest, so the first one whose buffered
+	// data already reaches back to since is the best choice: highest
+	// resolution without dropping any of the requested range.
+	var chosen *tier
+	for _, t := range eligible {
+		if oldest, ok := t.oldestSample(); ok && !oldest.After(since) {
+			chosen = t
+			break
+		}
+	}
+
+	// No tier's real data covers the full range. Fall back to whichever
+	// eligible tier has buffered the most history, so the result is at
+	// least a best-effort (truncated) answer instead of empty.
+	if chosen == nil {
+		var oldestSeen time.Time
+		for _, t := range eligible {
+			oldest, ok := t.oldestSample()
+			if !ok {
+				continue
+			}
+			if chosen == nil || oldest.Before(oldestSeen) {
+				chosen, oldestSeen = t, oldest
+			}
+		}
+	}
+	if chosen == nil {
+		chosen = eligible[0]
+	}
+
+	raw := chosen.query(since, until)
+	if step <= chosen.interval {
+		return raw
+	}
+	return downsample(raw, since, until, step, agg)
+}
+
+// downsample groups raw samples into step-sized buckets starting at since
+// and reduces each bucket with agg.
+func downsample(raw []Sample, since, until time.Time, step time.Duration, agg Aggregation) []Sample {
+	if len(raw) == 0 || step <= 0 {
+		return raw
+	}
+
+	buckets := map[int64][]float64{}
+	var order []int64
+	for _, s := range raw {
+		idx := int64(s.Ts.Sub(since) / step)
+		if _, ok := buckets[idx]; !ok {
+			order = append(order, idx)
+		}
+		buckets[idx] = append(buckets[idx], s.Value)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+
+	out := make([]Sample, 0, len(order))
+	for _, idx := range order {
+		out = append(out, Sample{
+			Ts:    since.Add(time.Duration(idx) * step),
+			Value: reduce(buckets[idx], agg),
+		})
+	}
+	return out
+}
+
+func reduce(values []float64, agg Aggregation) float64 {
+	switch agg {
+	case AggMin:
+		m := values[0]
+		for _, v := range values[1:] {
+			if v < m {
+				m = v
+			}
+		}
+		return m
+	case AggMax:
+		m := values[0]
+		for _, v := range values[1:] {
+			if v > m {
+				m = v
+			}
+		}
+		return m
+	case AggP95:
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		idx := int(float64(len(sorted)-1) * 0.95)
+		return sorted[idx]
+	default: // AggAvg
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values))
+	}
+}
@@ -0,0 +1,64 @@
+// Package history is an in-process time-series store for the metrics the
+// point-in-time tools (cpu, memory, network, ...) already expose, so an
+// agent can ask "what did cpu usage look like over the last hour" instead
+// of only ever seeing the current snapshot.
+package history
+
+import (
+	"sync"
+	"time"
+)
+
+type key struct {
+	metric string
+	label  string
+}
+
+// Store holds one series per (metric, label) pair. It is bounded in
+// memory: each series' tiers cap how many samples they retain regardless
+// of how long the process runs, which is what keeps this safe to run on
+// small devices.
+type Store struct {
+	tiers []TierConfig
+
+	mu     sync.Mutex
+	series map[key]*series
+}
+
+// NewStore creates a Store whose series use tiers as their retention
+// schedule. A nil tiers uses DefaultTiers.
+func NewStore(tiers []TierConfig) *Store {
+	if tiers == nil {
+		tiers = DefaultTiers
+	}
+	return &Store{tiers: tiers, series: make(map[key]*series)}
+}
+
+// Record adds a sample for metric (optionally scoped by label, e.g. a
+// mount point or interface name) observed at ts.
+func (s *Store) Record(metric, label string, ts time.Time, value float64) {
+	k := key{metric: metric, label: label}
+
+	s.mu.Lock()
+	ser, ok := s.series[k]
+	if !ok {
+		ser = newSeries(s.tiers)
+		s.series[k] = ser
+	}
+	s.mu.Unlock()
+
+	ser.record(ts, value)
+}
+
+// Query returns metric's samples (optionally scoped by label) in
+// [since, until], aggregated into step-sized points using agg. It returns
+// nil if no samples have ever been recorded for the series.
+func (s *Store) Query(metric, label string, since, until time.Time, step time.Duration, agg Aggregation) []Sample {
+	s.mu.Lock()
+	ser, ok := s.series[key{metric: metric, label: label}]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return ser.query(since, until, step, agg)
+}
@@ -0,0 +1,26 @@
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStoreQueryDefaultWindowCoversFullHour(t *testing.T) {
+	store := NewStore(nil)
+
+	base := time.Now().Add(-2 * time.Hour)
+	for i := 0; i < int(2*time.Hour/time.Second); i++ {
+		store.Record("cpu", "", base.Add(time.Duration(i)*time.Second), float64(i))
+	}
+
+	until := base.Add(2 * time.Hour)
+	since := until.Add(-time.Hour) // tool.go's default window when since/step are omitted
+
+	samples := store.Query("cpu", "", since, until, 0, AggAvg)
+	if len(samples) == 0 {
+		t.Fatal("expected samples covering the requested hour, got none")
+	}
+	if oldest := samples[0].Ts; oldest.Sub(since) > 2*time.Minute {
+		t.Fatalf("oldest sample at %s only covers the tail of the requested hour starting %s", oldest, since)
+	}
+}
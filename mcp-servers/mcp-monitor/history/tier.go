@@ -0,0 +1,103 @@
+package history
+
+import "time"
+
+// Sample is a single timestamped value in a Series.
+type Sample struct {
+	Ts    time.Time `json:"ts"`
+	Value float64   `json:"value"`
+}
+
+// TierConfig describes one retention tier: samples are bucketed into
+// Interval-sized buckets and kept until Retention worth of buckets have
+// accumulated, at which point the oldest bucket is evicted.
+type TierConfig struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// DefaultTiers is the store's default downsampling schedule: 1s resolution
+// for the last 5 minutes, 10s for the last hour, and 60s for the last day.
+var DefaultTiers = []TierConfig{
+	{Interval: time.Second, Retention: 5 * time.Minute},
+	{Interval: 10 * time.Second, Retention: time.Hour},
+	{Interval: time.Minute, Retention: 24 * time.Hour},
+}
+
+// tier is a bounded ring of Samples at a fixed Interval. Values recorded
+// within the same Interval bucket are averaged together before being
+// appended, which keeps memory use fixed regardless of how often record is
+// called.
+type tier struct {
+	interval time.Duration
+	capacity int
+	samples  []Sample
+
+	pendingStart time.Time
+	pendingSum   float64
+	pendingCount int
+}
+
+func newTier(cfg TierConfig) *tier {
+	capacity := int(cfg.Retention / cfg.Interval)
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &tier{interval: cfg.Interval, capacity: capacity}
+}
+
+// record folds v observed at ts into the current bucket. When ts rolls
+// into a new bucket, the just-closed bucket is appended to the ring and
+// returned so the caller can feed it to the next, coarser tier.
+func (t *tier) record(ts time.Time, v float64) (Sample, bool) {
+	bucket := ts.Truncate(t.interval)
+
+	if t.pendingStart.IsZero() {
+		t.pendingStart = bucket
+	}
+
+	if bucket.After(t.pendingStart) {
+		closed := Sample{Ts: t.pendingStart, Value: t.pendingSum / float64(t.pendingCount)}
+		t.append(closed)
+		t.pendingStart = bucket
+		t.pendingSum = v
+		t.pendingCount = 1
+		return closed, true
+	}
+
+	t.pendingSum += v
+	t.pendingCount++
+	return Sample{}, false
+}
+
+// oldestSample returns the timestamp of the oldest sample currently
+// buffered in this tier's ring, or false if the tier hasn't closed its
+// first bucket yet. This reflects what the tier actually holds, which can
+// trail its nominal retention (capacity * interval) by up to one bucket
+// interval -- and, for a tier fed by cascading from a finer tier below it,
+// by that finer tier's own lag as well.
+func (t *tier) oldestSample() (time.Time, bool) {
+	if len(t.samples) == 0 {
+		return time.Time{}, false
+	}
+	return t.samples[0].Ts, true
+}
+
+func (t *tier) append(s Sample) {
+	t.samples = append(t.samples, s)
+	if len(t.samples) > t.capacity {
+		t.samples = t.samples[len(t.samples)-t.capacity:]
+	}
+}
+
+// query returns the samples in [since, until].
+func (t *tier) query(since, until time.Time) []Sample {
+	var out []Sample
+	for _, s := range t.samples {
+		if s.Ts.Before(since) || s.Ts.After(until) {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// NewTool describes a "<metric>.history" MCP tool; NewHandler supplies its
+// Store-backed handler.
+func NewTool(metric string) mcp.Tool {
+	return mcp.NewTool(metric+".history",
+		mcp.WithDescription(fmt.Sprintf("Query historical %s samples over a time range", metric)),
+		mcp.WithString("label",
+			mcp.Description("optional scope for the metric, e.g. a network interface name"),
+		),
+		mcp.WithString("since",
+			mcp.Description("start of the range, RFC3339 (default: 1 hour ago)"),
+		),
+		mcp.WithString("until",
+			mcp.Description("end of the range, RFC3339 (default: now)"),
+		),
+		mcp.WithString("step",
+			mcp.Description("bucket size as a Go duration string, e.g. \"10s\" (default: the finest retention tier with enough buffered history to cover the full range, falling back to whichever tier covers the most of it if none do)"),
+		),
+		mcp.WithString("agg",
+			mcp.Description("aggregation applied within each bucket: avg, min, max, or p95 (default avg)"),
+		),
+	)
+}
+
+// NewHandler returns the handler for metric's "<metric>.history" tool,
+// querying store.
+func NewHandler(store *Store, metric string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		until := time.Now()
+		if raw := request.GetString("until", ""); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid until: %w", err)
+			}
+			until = t
+		}
+
+		since := until.Add(-time.Hour)
+		if raw := request.GetString("since", ""); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid since: %w", err)
+			}
+			since = t
+		}
+
+		var step time.Duration
+		if raw := request.GetString("step", ""); raw != "" {
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid step: %w", err)
+			}
+			step = d
+		}
+
+		agg := Aggregation(request.GetString("agg", string(AggAvg)))
+		label := request.GetString("label", "")
+
+		samples := store.Query(metric, label, since, until, step, agg)
+
+		data, err := json.MarshalIndent(samples, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal samples: %w", err)
+		}
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
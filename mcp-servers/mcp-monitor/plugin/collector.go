@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"github.com/seekrays/mcp-monitor/plugin/proto"
+)
+
+// Collector is the interface a third-party collector plugin implements.
+type Collector interface {
+	Name() string
+	ToolSpec() []proto.Tool
+	Handle(ctx context.Context, tool string, argsJSON json.RawMessage) (json.RawMessage, error)
+}
+
+// Plugin adapts a Collector to hashicorp/go-plugin's gRPC plugin
+// interface. A plugin binary's main() calls Serve with its Collector
+// implementation; the host uses Plugin to obtain a proto.CollectorClient.
+type Plugin struct {
+	goplugin.NetRPCUnsupportedPlugin
+	Impl Collector
+}
+
+func (p *Plugin) GRPCServer(_ *goplugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterCollectorServer(s, &collectorServer{impl: p.Impl})
+	return nil
+}
+
+func (p *Plugin) GRPCClient(_ context.Context, _ *goplugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return proto.NewCollectorClient(conn), nil
+}
+
+// collectorServer adapts a Collector to proto.CollectorServer.
+type collectorServer struct {
+	impl Collector
+}
+
+func (s *collectorServer) Name(_ context.Context, _ *proto.Empty) (*proto.NameResponse, error) {
+	return &proto.NameResponse{Name: s.impl.Name()}, nil
+}
+
+func (s *collectorServer) ToolSpec(_ context.Context, _ *proto.Empty) (*proto.ToolSpecResponse, error) {
+	return &proto.ToolSpecResponse{Tools: s.impl.ToolSpec()}, nil
+}
+
+func (s *collectorServer) Handle(ctx context.Context, req *proto.HandleRequest) (*proto.HandleResponse, error) {
+	result, err := s.impl.Handle(ctx, req.Tool, json.RawMessage(req.ArgsJSON))
+	if err != nil {
+		return &proto.HandleResponse{Error: err.Error()}, nil
+	}
+	return &proto.HandleResponse{ResultJSON: string(result)}, nil
+}
+
+// Serve runs impl as a plugin subprocess, blocking until the host
+// disconnects. Call this from a plugin binary's main().
+func Serve(impl Collector) {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]goplugin.Plugin{
+			"collector": &Plugin{Impl: impl},
+		},
+		GRPCServer: goplugin.DefaultGRPCServer,
+	})
+}
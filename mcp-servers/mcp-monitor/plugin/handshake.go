@@ -0,0 +1,16 @@
+// Package plugin hosts third-party collectors as gRPC subprocesses via
+// hashicorp/go-plugin, so users can ship proprietary collectors (GPU
+// stats, Docker, systemd units, Kubernetes node info, ...) without
+// forking this repo.
+package plugin
+
+import goplugin "github.com/hashicorp/go-plugin"
+
+// Handshake is shared between the host and plugin subprocesses so each
+// side can confirm it's talking to the other and not some unrelated
+// process that happens to share a pipe.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "MCP_MONITOR_PLUGIN",
+	MagicCookieValue: "collector",
+}
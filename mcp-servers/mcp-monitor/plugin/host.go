@@ -0,0 +1,161 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/seekrays/mcp-monitor/plugin/proto"
+	"github.com/seekrays/mcp-monitor/registry"
+)
+
+// Host discovers, launches, and proxies third-party collector plugins.
+type Host struct {
+	allow   map[string]bool // nil means allow every discovered plugin
+	timeout time.Duration
+
+	mu      sync.Mutex
+	clients []*goplugin.Client
+}
+
+// NewHost creates a Host. An empty allow list permits every plugin found
+// under the scanned directory; callTimeout bounds each Handle call so a
+// stuck plugin can't hang the server.
+func NewHost(allow []string, callTimeout time.Duration) *Host {
+	var allowSet map[string]bool
+	if len(allow) > 0 {
+		allowSet = make(map[string]bool, len(allow))
+		for _, a := range allow {
+			allowSet[a] = true
+		}
+	}
+	return &Host{allow: allowSet, timeout: callTimeout}
+}
+
+// LoadDir scans dir for executable plugins, handshakes with each, and
+// registers their tools into the shared registry under
+// "plugin.<name>.<tool>". A single misbehaving plugin is logged and
+// skipped rather than failing startup.
+func (h *Host) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read plugins dir %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0o111 == 0 {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		if h.allow != nil && !h.allow[entry.Name()] {
+			log.Printf("plugin %s not in --plugin-allow, skipping", entry.Name())
+			continue
+		}
+		if err := h.load(path); err != nil {
+			log.Printf("plugin %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func (h *Host) load(path string) error {
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          map[string]goplugin.Plugin{"collector": &Plugin{}},
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("handshake: %w", err)
+	}
+
+	raw, err := rpcClient.Dispense("collector")
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("dispense: %w", err)
+	}
+	collector := raw.(proto.CollectorClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	nameResp, err := collector.Name(ctx, &proto.Empty{})
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("name: %w", err)
+	}
+	specResp, err := collector.ToolSpec(ctx, &proto.Empty{})
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("tool spec: %w", err)
+	}
+
+	h.mu.Lock()
+	h.clients = append(h.clients, client)
+	h.mu.Unlock()
+
+	for _, t := range specResp.Tools {
+		toolName := fmt.Sprintf("plugin.%s.%s", nameResp.Name, t.Name)
+		registry.Register(toolName, buildTool(toolName, t), h.handler(collector, t.Name))
+		log.Printf("Registered plugin tool %s (from %s)", toolName, path)
+	}
+	return nil
+}
+
+// buildTool describes a plugin-supplied tool. Plugins own their own
+// argument validation, so the tool is registered with just a name and
+// description; the host forwards whatever arguments the client sends.
+func buildTool(name string, t proto.Tool) mcp.Tool {
+	return mcp.NewTool(name, mcp.WithDescription(t.Description))
+}
+
+func (h *Host) handler(collector proto.CollectorClient, tool string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		argsJSON, err := json.Marshal(request.GetArguments())
+		if err != nil {
+			return nil, fmt.Errorf("marshal args: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+
+		resp, err := collector.Handle(ctx, &proto.HandleRequest{Tool: tool, ArgsJSON: string(argsJSON)})
+		if err != nil {
+			return nil, fmt.Errorf("plugin call: %w", err)
+		}
+		if resp.Error != "" {
+			return nil, fmt.Errorf("plugin error: %s", resp.Error)
+		}
+		return mcp.NewToolResultText(resp.ResultJSON), nil
+	}
+}
+
+// Close gracefully shuts down every plugin subprocess this Host launched.
+func (h *Host) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, c := range h.clients {
+		c.Kill()
+	}
+}
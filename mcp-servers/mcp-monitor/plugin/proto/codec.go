@@ -0,0 +1,38 @@
+package proto
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// jsonCodecName is registered with grpc's encoding package. The plugin host
+// selects it on every call via grpc.CallContentSubtype, and the plugin
+// subprocess's gRPC server picks the same registered codec from each
+// request's content subtype, so neither side needs real protobuf marshaling
+// for the simple JSON-string payloads this service carries.
+const jsonCodecName = "json"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return jsonCodecName }
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %T: %w", v, err)
+	}
+	return data, nil
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("unmarshal %T: %w", v, err)
+	}
+	return nil
+}
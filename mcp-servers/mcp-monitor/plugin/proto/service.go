@@ -0,0 +1,110 @@
+package proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// CollectorServer is implemented by a plugin subprocess.
+type CollectorServer interface {
+	Name(context.Context, *Empty) (*NameResponse, error)
+	ToolSpec(context.Context, *Empty) (*ToolSpecResponse, error)
+	Handle(context.Context, *HandleRequest) (*HandleResponse, error)
+}
+
+// CollectorClient is implemented by the hand-written client stub the host
+// uses to call into a plugin subprocess.
+type CollectorClient interface {
+	Name(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NameResponse, error)
+	ToolSpec(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ToolSpecResponse, error)
+	Handle(ctx context.Context, in *HandleRequest, opts ...grpc.CallOption) (*HandleResponse, error)
+}
+
+type collectorClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewCollectorClient wraps conn as a CollectorClient whose calls all use
+// the json codec.
+func NewCollectorClient(conn *grpc.ClientConn) CollectorClient {
+	return &collectorClient{cc: conn}
+}
+
+func (c *collectorClient) Name(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NameResponse, error) {
+	out := new(NameResponse)
+	if err := c.cc.Invoke(ctx, "/proto.Collector/Name", in, out, withJSONCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) ToolSpec(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ToolSpecResponse, error) {
+	out := new(ToolSpecResponse)
+	if err := c.cc.Invoke(ctx, "/proto.Collector/ToolSpec", in, out, withJSONCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorClient) Handle(ctx context.Context, in *HandleRequest, opts ...grpc.CallOption) (*HandleResponse, error) {
+	out := new(HandleResponse)
+	if err := c.cc.Invoke(ctx, "/proto.Collector/Handle", in, out, withJSONCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// withJSONCodec forces every call through jsonCodec instead of grpc-go's
+// default proto codec, since these messages are plain structs rather than
+// proto.Message implementations. It must be applied at every call site:
+// registering the codec alone doesn't select it.
+func withJSONCodec(opts []grpc.CallOption) []grpc.CallOption {
+	return append([]grpc.CallOption{grpc.CallContentSubtype(jsonCodecName)}, opts...)
+}
+
+// RegisterCollectorServer registers srv as the Collector implementation on
+// s. Called from plugin.Plugin's GRPCServer when a plugin subprocess runs
+// plugin.Serve.
+func RegisterCollectorServer(s grpc.ServiceRegistrar, srv CollectorServer) {
+	s.RegisterService(&_Collector_serviceDesc, srv)
+}
+
+var _Collector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Collector",
+	HandlerType: (*CollectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Name",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(Empty)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				return srv.(CollectorServer).Name(ctx, in)
+			},
+		},
+		{
+			MethodName: "ToolSpec",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(Empty)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				return srv.(CollectorServer).ToolSpec(ctx, in)
+			},
+		},
+		{
+			MethodName: "Handle",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(HandleRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				return srv.(CollectorServer).Handle(ctx, in)
+			},
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin/proto/collector.proto",
+}
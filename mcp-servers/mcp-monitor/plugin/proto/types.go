@@ -0,0 +1,43 @@
+// Package proto defines the Collector gRPC contract described in
+// collector.proto. Messages are plain Go structs carried over gRPC using
+// the "json" codec registered in codec.go rather than protoc-generated
+// binary marshaling, so the contract can change without a protoc step in
+// every contributor's toolchain while still going over a real gRPC
+// connection (handshake, streaming, deadlines, and all).
+package proto
+
+// Empty is sent for RPCs that take no arguments.
+type Empty struct{}
+
+// NameResponse is returned by Collector.Name.
+type NameResponse struct {
+	Name string `json:"name"`
+}
+
+// Tool describes one MCP tool a plugin exposes. JSONSchema is the tool's
+// input schema, JSON-encoded, so the host doesn't need to know every
+// plugin's argument shape up front.
+type Tool struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	JSONSchema  string `json:"json_schema"`
+}
+
+// ToolSpecResponse is returned by Collector.ToolSpec.
+type ToolSpecResponse struct {
+	Tools []Tool `json:"tools"`
+}
+
+// HandleRequest is sent to Collector.Handle. ArgsJSON is the call's
+// arguments, JSON-encoded.
+type HandleRequest struct {
+	Tool     string `json:"tool"`
+	ArgsJSON string `json:"args_json"`
+}
+
+// HandleResponse is returned by Collector.Handle. Exactly one of
+// ResultJSON or Error is set.
+type HandleResponse struct {
+	ResultJSON string `json:"result_json,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
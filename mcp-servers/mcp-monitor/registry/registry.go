@@ -0,0 +1,50 @@
+// Package registry is a shared catalogue of the MCP tools this server can
+// expose, so collectors can be discovered and selectively enabled without
+// hard-coding each one into main.
+package registry
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Entry pairs a collector's MCP tool definition and handler with the short
+// name used to enable/disable it via --tools.
+type Entry struct {
+	Name    string
+	Tool    mcp.Tool
+	Handler server.ToolHandlerFunc
+}
+
+var entries []Entry
+
+// Register adds a collector to the shared registry.
+func Register(name string, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	entries = append(entries, Entry{Name: name, Tool: tool, Handler: handler})
+}
+
+// All returns every registered collector, in registration order.
+func All() []Entry {
+	return entries
+}
+
+// Filter returns the registered collectors whose name appears in names. An
+// empty names slice matches everything, which keeps "--tools" optional.
+func Filter(names []string) []Entry {
+	if len(names) == 0 {
+		return All()
+	}
+
+	want := make(map[string]bool, len(names))
+	for _, n := range names {
+		want[n] = true
+	}
+
+	var out []Entry
+	for _, e := range entries {
+		if want[e.Name] {
+			out = append(out, e)
+		}
+	}
+	return out
+}
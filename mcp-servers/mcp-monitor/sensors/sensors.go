@@ -0,0 +1,64 @@
+// Package sensors exposes temperature, fan, and battery readings, which
+// matter most for SBCs and edge devices where thermal throttling is a real
+// concern.
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+// Reading is a single sensor's current value.
+type Reading struct {
+	Name     string    `json:"name"`
+	Kind     string    `json:"kind"` // temperature, fan, or battery
+	Value    float64   `json:"value"`
+	Unit     string    `json:"unit"`
+	High     *float64  `json:"high,omitempty"`
+	Critical *float64  `json:"critical,omitempty"`
+	Since    time.Time `json:"since"`
+}
+
+// NewTool describes the "sensors" MCP tool.
+func NewTool() mcp.Tool {
+	return mcp.NewTool("sensors",
+		mcp.WithDescription("Get temperature, fan, and battery sensor readings"),
+	)
+}
+
+// Handler reports every sensor reading gopsutil and, on Linux, the sysfs
+// thermal/power_supply/hwmon trees can find.
+func Handler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	now := time.Now()
+	var readings []Reading
+
+	temps, err := host.SensorsTemperaturesWithContext(ctx)
+	if err != nil && len(temps) == 0 {
+		return nil, fmt.Errorf("read sensor temperatures: %w", err)
+	}
+	for _, t := range temps {
+		r := Reading{Name: t.SensorKey, Kind: "temperature", Value: t.Temperature, Unit: "celsius", Since: now}
+		if t.High > 0 {
+			high := t.High
+			r.High = &high
+		}
+		if t.Critical > 0 {
+			crit := t.Critical
+			r.Critical = &crit
+		}
+		readings = append(readings, r)
+	}
+
+	readings = append(readings, platformReadings(now)...)
+
+	data, err := json.MarshalIndent(readings, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal sensor readings: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,106 @@
+//go:build linux
+
+package sensors
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// platformReadings adds Linux-specific sensors that gopsutil's
+// cross-platform SensorsTemperatures doesn't cover: thermal zones (as a
+// fallback alongside hwmon), hwmon fan RPMs, and battery state.
+func platformReadings(now time.Time) []Reading {
+	var out []Reading
+	out = append(out, thermalZoneReadings(now)...)
+	out = append(out, hwmonFanReadings(now)...)
+	out = append(out, batteryReadings(now)...)
+	return out
+}
+
+func thermalZoneReadings(now time.Time) []Reading {
+	zones, _ := filepath.Glob("/sys/class/thermal/thermal_zone*")
+	var out []Reading
+	for _, zone := range zones {
+		millideg, err := readInt(filepath.Join(zone, "temp"))
+		if err != nil {
+			continue
+		}
+		name, err := readString(filepath.Join(zone, "type"))
+		if err != nil {
+			name = filepath.Base(zone)
+		}
+		out = append(out, Reading{
+			Name:  name,
+			Kind:  "temperature",
+			Value: float64(millideg) / 1000,
+			Unit:  "celsius",
+			Since: now,
+		})
+	}
+	return out
+}
+
+func hwmonFanReadings(now time.Time) []Reading {
+	inputs, _ := filepath.Glob("/sys/class/hwmon/hwmon*/fan*_input")
+	var out []Reading
+	for _, input := range inputs {
+		rpm, err := readInt(input)
+		if err != nil {
+			continue
+		}
+		out = append(out, Reading{
+			Name:  filepath.Base(filepath.Dir(input)) + "/" + filepath.Base(input),
+			Kind:  "fan",
+			Value: float64(rpm),
+			Unit:  "rpm",
+			Since: now,
+		})
+	}
+	return out
+}
+
+func batteryReadings(now time.Time) []Reading {
+	supplies, _ := filepath.Glob("/sys/class/power_supply/*")
+	var out []Reading
+	for _, supply := range supplies {
+		typ, err := readString(filepath.Join(supply, "type"))
+		if err != nil || typ != "Battery" {
+			continue
+		}
+
+		pct, err := readInt(filepath.Join(supply, "capacity"))
+		if err != nil {
+			continue
+		}
+
+		r := Reading{
+			Name:  filepath.Base(supply),
+			Kind:  "battery",
+			Value: float64(pct),
+			Unit:  "percent",
+			Since: now,
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func readInt(path string) (int, error) {
+	s, err := readString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(s)
+}
+
+func readString(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package sensors
+
+import "time"
+
+// platformReadings has no additional sensors outside of Linux; gopsutil's
+// cross-platform SensorsTemperatures is all we get.
+func platformReadings(now time.Time) []Reading {
+	return nil
+}